@@ -181,6 +181,71 @@ func (c *client) FindProviders(ctx context.Context, key cid.Cid) (provs iter.Ite
 	}
 }
 
+func (c *client) FindPeers(ctx context.Context, pid peer.ID) (peers iter.Iter[types.PeerRecord], err error) {
+	measurement := newMeasurement("FindPeers")
+	defer func() {
+		if sliceIter, ok := peers.(*iter.SliceIter[types.PeerRecord]); ok {
+			length := len(sliceIter.Slice)
+			measurement.length = &length
+		}
+		measurement.record(ctx)
+	}()
+
+	url := c.baseURL + server.FindPeersPath + peer.ToCid(pid).String()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	measurement.host = req.Host
+
+	start := c.clock.Now()
+	resp, err := c.httpClient.Do(req)
+
+	measurement.err = err
+	measurement.latency = c.clock.Since(start)
+
+	if err != nil {
+		return nil, err
+	}
+
+	measurement.statusCode = resp.StatusCode
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, httpError(resp.StatusCode, resp.Body)
+	}
+
+	respContentType := resp.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(respContentType)
+	if err != nil {
+		resp.Body.Close()
+		return nil, fmt.Errorf("parsing Content-Type: %w", err)
+	}
+
+	switch mediaType {
+	case mediaTypeJSON:
+		defer resp.Body.Close()
+		parsedResp := &jsontypes.ReadPeersResponse{}
+		err = json.NewDecoder(resp.Body).Decode(parsedResp)
+		iter := iter.FromSlice(parsedResp.Peers)
+		return iter, err
+
+	case mediaTypeNDJSON:
+		iter := ndjson.NewReadPeerResponseIter(ctx, resp.Body)
+		return iter, nil
+
+	default:
+		defer resp.Body.Close()
+		logger.Errorw("unknown media type", "MediaType", mediaType, "ContentType", respContentType)
+		return nil, errors.New("unknown content type")
+	}
+}
+
 func (c *client) ProvideBitswap(ctx context.Context, keys []cid.Cid, ttl time.Duration) (time.Duration, error) {
 	if c.identity == nil {
 		return 0, errors.New("cannot provide Bitswap records without an identity")
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func newTestPeerID(t *testing.T) peer.ID {
+	t.Helper()
+	pid, err := peer.Decode("12D3KooWGC6TvWhfapngX6wvJHMYvSfEzgRaNdmKE9M3q2tdVmBn")
+	require.NoError(t, err)
+	return pid
+}
+
+func TestFindPeers(t *testing.T) {
+	pid := newTestPeerID(t)
+
+	t.Run("404 returns a nil iterator and no error", func(t *testing.T) {
+		c, err := New("http://example.com", WithHTTPClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(""))}, nil
+		})))
+		require.NoError(t, err)
+
+		it, err := c.FindPeers(context.Background(), pid)
+		require.NoError(t, err)
+		require.Nil(t, it)
+	})
+
+	t.Run("application/json response is decoded", func(t *testing.T) {
+		body := `{"Peers":[{"Schema":"peer","Protocols":["transport-bitswap"]}]}`
+		c, err := New("http://example.com", WithHTTPClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		})))
+		require.NoError(t, err)
+
+		it, err := c.FindPeers(context.Background(), pid)
+		require.NoError(t, err)
+		require.NotNil(t, it)
+
+		rec, ok, err := it.Next()
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, []string{"transport-bitswap"}, rec.Protocols)
+
+		_, ok, err = it.Next()
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	t.Run("application/x-ndjson response is decoded", func(t *testing.T) {
+		body := `{"Schema":"peer","Protocols":["transport-bitswap"]}` + "\n"
+		c, err := New("http://example.com", WithHTTPClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/x-ndjson"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		})))
+		require.NoError(t, err)
+
+		it, err := c.FindPeers(context.Background(), pid)
+		require.NoError(t, err)
+		require.NotNil(t, it)
+
+		rec, ok, err := it.Next()
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, []string{"transport-bitswap"}, rec.Protocols)
+	})
+
+	t.Run("unknown content type returns an error", func(t *testing.T) {
+		c, err := New("http://example.com", WithHTTPClient(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"text/plain"}},
+				Body:       io.NopCloser(strings.NewReader("nope")),
+			}, nil
+		})))
+		require.NoError(t, err)
+
+		_, err = c.FindPeers(context.Background(), pid)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,20 @@
+package contentrouter
+
+import (
+	"context"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-libipfs/routing/http/types"
+	"github.com/ipfs/go-libipfs/routing/http/types/iter"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// Client is the interface implemented by the delegated routing HTTP client,
+// used to decouple consumers of content and peer routing from the concrete
+// HTTP implementation.
+type Client interface {
+	FindProviders(ctx context.Context, key cid.Cid) (iter.Iter[types.ProviderResponse], error)
+	ProvideBitswap(ctx context.Context, keys []cid.Cid, ttl time.Duration) (time.Duration, error)
+	FindPeers(ctx context.Context, pid peer.ID) (iter.Iter[types.PeerRecord], error)
+}
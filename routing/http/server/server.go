@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-libipfs/routing/http/types"
+	"github.com/ipfs/go-libipfs/routing/http/types/iter"
+	jsontypes "github.com/ipfs/go-libipfs/routing/http/types/json"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	mediaTypeJSON   = "application/json"
+	mediaTypeNDJSON = "application/x-ndjson"
+)
+
+// FindPeersPath is the path, relative to the server's base URL, at which
+// delegated peer routing records (IPIP-417) are served.
+const FindPeersPath = "/routing/v1/peers/"
+
+// ContentRouter is the interface implemented by a delegated routing backend.
+type ContentRouter interface {
+	// FindPeers returns peer routing records for the given peer ID.
+	FindPeers(ctx context.Context, pid peer.ID) (iter.Iter[types.PeerRecord], error)
+}
+
+type server struct {
+	svc ContentRouter
+}
+
+// Handler returns an http.Handler that serves the delegated peer routing
+// endpoint under FindPeersPath, backed by svc. No other routes are registered.
+func Handler(svc ContentRouter) http.Handler {
+	s := &server{svc: svc}
+	mux := http.NewServeMux()
+	mux.HandleFunc(FindPeersPath, s.handleFindPeers)
+	return mux
+}
+
+func (s *server) handleFindPeers(w http.ResponseWriter, httpReq *http.Request) {
+	pidCidStr := strings.TrimPrefix(httpReq.URL.Path, FindPeersPath)
+	pidCid, err := cid.Decode(pidCidStr)
+	if err != nil {
+		http.Error(w, "invalid peer cid: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pid, err := peer.FromCid(pidCid)
+	if err != nil {
+		http.Error(w, "invalid peer cid: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	recordsIter, err := s.svc.FindPeers(httpReq.Context(), pid)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if recordsIter == nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	if acceptsNDJSON(httpReq) {
+		w.Header().Set("Content-Type", mediaTypeNDJSON)
+		enc := json.NewEncoder(w)
+		for {
+			rec, ok, err := recordsIter.Next()
+			if !ok {
+				return
+			}
+			if err != nil {
+				return
+			}
+			if err := enc.Encode(rec); err != nil {
+				return
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", mediaTypeJSON)
+	var records []types.PeerRecord
+	for {
+		rec, ok, err := recordsIter.Next()
+		if !ok {
+			break
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		records = append(records, rec)
+	}
+	_ = json.NewEncoder(w).Encode(jsontypes.ReadPeersResponse{Peers: records})
+}
+
+func acceptsNDJSON(httpReq *http.Request) bool {
+	return strings.Contains(httpReq.Header.Get("Accept"), mediaTypeNDJSON)
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ipfs/go-libipfs/routing/http/types"
+	"github.com/ipfs/go-libipfs/routing/http/types/iter"
+	jsontypes "github.com/ipfs/go-libipfs/routing/http/types/json"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeContentRouter struct {
+	findPeersFunc func(ctx context.Context, pid peer.ID) (iter.Iter[types.PeerRecord], error)
+}
+
+func (f fakeContentRouter) FindPeers(ctx context.Context, pid peer.ID) (iter.Iter[types.PeerRecord], error) {
+	return f.findPeersFunc(ctx, pid)
+}
+
+func testPeerID(t *testing.T) peer.ID {
+	t.Helper()
+	_, pub, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+	return pid
+}
+
+func TestHandleFindPeers(t *testing.T) {
+	pid := testPeerID(t)
+	pidCidStr := peer.ToCid(pid).String()
+	rec := types.PeerRecord{Schema: types.SchemaPeer, Protocols: []string{"transport-bitswap"}}
+
+	t.Run("not found", func(t *testing.T) {
+		router := fakeContentRouter{findPeersFunc: func(ctx context.Context, p peer.ID) (iter.Iter[types.PeerRecord], error) {
+			return nil, nil
+		}}
+		srv := httptest.NewServer(Handler(router))
+		defer srv.Close()
+
+		resp, err := http.Get(srv.URL + FindPeersPath + pidCidStr)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("invalid cid", func(t *testing.T) {
+		router := fakeContentRouter{findPeersFunc: func(ctx context.Context, p peer.ID) (iter.Iter[types.PeerRecord], error) {
+			t.Fatal("FindPeers should not be called for an invalid cid")
+			return nil, nil
+		}}
+		srv := httptest.NewServer(Handler(router))
+		defer srv.Close()
+
+		resp, err := http.Get(srv.URL + FindPeersPath + "not-a-cid")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("json response", func(t *testing.T) {
+		router := fakeContentRouter{findPeersFunc: func(ctx context.Context, p peer.ID) (iter.Iter[types.PeerRecord], error) {
+			require.Equal(t, pid, p)
+			return iter.FromSlice([]types.PeerRecord{rec}), nil
+		}}
+		srv := httptest.NewServer(Handler(router))
+		defer srv.Close()
+
+		req, err := http.NewRequest(http.MethodGet, srv.URL+FindPeersPath+pidCidStr, nil)
+		require.NoError(t, err)
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		var parsed jsontypes.ReadPeersResponse
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&parsed))
+		require.Len(t, parsed.Peers, 1)
+		require.Equal(t, rec.Protocols, parsed.Peers[0].Protocols)
+	})
+
+	t.Run("ndjson response", func(t *testing.T) {
+		router := fakeContentRouter{findPeersFunc: func(ctx context.Context, p peer.ID) (iter.Iter[types.PeerRecord], error) {
+			return iter.FromSlice([]types.PeerRecord{rec}), nil
+		}}
+		srv := httptest.NewServer(Handler(router))
+		defer srv.Close()
+
+		req, err := http.NewRequest(http.MethodGet, srv.URL+FindPeersPath+pidCidStr, nil)
+		require.NoError(t, err)
+		req.Header.Set("Accept", "application/x-ndjson")
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+
+		var got types.PeerRecord
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+		require.Equal(t, rec.Protocols, got.Protocols)
+	})
+}
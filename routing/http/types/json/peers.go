@@ -0,0 +1,9 @@
+package json
+
+import "github.com/ipfs/go-libipfs/routing/http/types"
+
+// ReadPeersResponse is the response returned by GET /routing/v1/peers/{cid}
+// when the client negotiates application/json instead of ndjson.
+type ReadPeersResponse struct {
+	Peers []types.PeerRecord
+}
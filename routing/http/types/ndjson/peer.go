@@ -0,0 +1,44 @@
+package ndjson
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ipfs/go-libipfs/routing/http/types"
+	"github.com/ipfs/go-libipfs/routing/http/types/iter"
+)
+
+type readPeerResponseIter struct {
+	iter iter.Iter[types.UnknownPeerRecord]
+}
+
+// NewReadPeerResponseIter returns an iterator over ndjson-encoded peer
+// records, dispatching on the record's schema like
+// NewReadProvidersResponseIter does for provider records. Unlike that
+// iterator, a record with a schema other than SchemaPeer yields an error.
+func NewReadPeerResponseIter(ctx context.Context, r io.Reader) *readPeerResponseIter {
+	return &readPeerResponseIter{iter: iter.FromReaderJSON[types.UnknownPeerRecord](ctx, r)}
+}
+
+func (p *readPeerResponseIter) Next() (types.PeerRecord, bool, error) {
+	v, ok, err := p.iter.Next()
+	if !ok {
+		return types.PeerRecord{}, false, nil
+	}
+	if err != nil {
+		return types.PeerRecord{}, false, err
+	}
+	switch v.Schema {
+	case types.SchemaPeer:
+		var rec types.PeerRecord
+		err := json.Unmarshal(v.Bytes, &rec)
+		if err != nil {
+			return types.PeerRecord{}, false, err
+		}
+		return rec, true, nil
+	default:
+		return types.PeerRecord{}, false, fmt.Errorf("unknown schema %q for PeerRecord", v.Schema)
+	}
+}
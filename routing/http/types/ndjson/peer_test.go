@@ -0,0 +1,43 @@
+package ndjson
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ipfs/go-libipfs/routing/http/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPeerResponseIter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("bad record returns an error", func(t *testing.T) {
+		it := NewReadPeerResponseIter(ctx, strings.NewReader(`{not valid json`))
+		rec, ok, err := it.Next()
+		require.Error(t, err)
+		require.False(t, ok)
+		require.Equal(t, types.PeerRecord{}, rec)
+	})
+
+	t.Run("unknown schema returns an error", func(t *testing.T) {
+		it := NewReadPeerResponseIter(ctx, strings.NewReader(`{"Schema":"something-else"}`))
+		rec, ok, err := it.Next()
+		require.Error(t, err)
+		require.False(t, ok)
+		require.Equal(t, types.PeerRecord{}, rec)
+	})
+
+	t.Run("peer schema is decoded", func(t *testing.T) {
+		it := NewReadPeerResponseIter(ctx, strings.NewReader(`{"Schema":"peer","Protocols":["transport-bitswap"]}`))
+		rec, ok, err := it.Next()
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, types.SchemaPeer, rec.Schema)
+		require.Equal(t, []string{"transport-bitswap"}, rec.Protocols)
+
+		_, ok, err = it.Next()
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+}
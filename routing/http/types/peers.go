@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// SchemaPeer is the schema used to announce and read peer routing records.
+const SchemaPeer = "peer"
+
+// PeerRecord is a protocol-agnostic representation of a peer routing record.
+// Unlike provider records, peer records are not tied to a specific
+// transport or protocol, so this is the only record type returned by the
+// delegated peer routing endpoint today. Extra is kept around so that
+// fields added by future revisions of the schema survive a round trip.
+type PeerRecord struct {
+	Schema    string
+	ID        *peer.ID
+	Addrs     []Multiaddr
+	Protocols []string
+
+	// Extra contains any additional fields present in the record that
+	// aren't part of this schema.
+	Extra map[string]json.RawMessage `json:",omitempty"`
+}
+
+// peerRecordAlias avoids infinite recursion when PeerRecord's own
+// MarshalJSON/UnmarshalJSON delegate to the standard encoding for the known
+// fields.
+type peerRecordAlias PeerRecord
+
+func (pr PeerRecord) MarshalJSON() ([]byte, error) {
+	alias := peerRecordAlias(pr)
+	alias.Extra = nil
+	b, err := json.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range pr.Extra {
+		m[k] = v
+	}
+	return json.Marshal(m)
+}
+
+func (pr *PeerRecord) UnmarshalJSON(b []byte) error {
+	alias := (*peerRecordAlias)(pr)
+	if err := json.Unmarshal(b, alias); err != nil {
+		return err
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	for _, known := range []string{"Schema", "ID", "Addrs", "Protocols"} {
+		delete(m, known)
+	}
+	if len(m) > 0 {
+		pr.Extra = m
+	}
+	return nil
+}
+
+// UnknownPeerRecord is used as an intermediate type to determine the schema
+// of a peer record before unmarshaling it into a concrete type.
+type UnknownPeerRecord struct {
+	Schema string
+	Bytes  []byte
+}
+
+func (u UnknownPeerRecord) MarshalJSON() ([]byte, error) {
+	return u.Bytes, nil
+}
+
+func (u *UnknownPeerRecord) UnmarshalJSON(b []byte) error {
+	u.Bytes = b
+	header := struct {
+		Schema string
+	}{}
+	if err := json.Unmarshal(b, &header); err != nil {
+		return err
+	}
+	u.Schema = header.Schema
+	return nil
+}
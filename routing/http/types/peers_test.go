@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerRecordRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name  string
+		json  string
+		extra map[string]json.RawMessage
+	}{
+		{
+			name: "no extra fields",
+			json: `{"Schema":"peer","Protocols":["transport-bitswap"]}`,
+		},
+		{
+			name: "unknown fields are preserved in Extra",
+			json: `{"Schema":"peer","Protocols":["transport-bitswap"],"Foo":"bar","Baz":1}`,
+			extra: map[string]json.RawMessage{
+				"Foo": json.RawMessage(`"bar"`),
+				"Baz": json.RawMessage(`1`),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var rec PeerRecord
+			err := json.Unmarshal([]byte(tc.json), &rec)
+			require.NoError(t, err)
+			require.Equal(t, tc.extra, rec.Extra)
+
+			b, err := json.Marshal(rec)
+			require.NoError(t, err)
+
+			var roundTripped map[string]json.RawMessage
+			require.NoError(t, json.Unmarshal(b, &roundTripped))
+
+			for k, v := range tc.extra {
+				require.JSONEq(t, string(v), string(roundTripped[k]))
+			}
+
+			var rec2 PeerRecord
+			require.NoError(t, json.Unmarshal(b, &rec2))
+			require.Equal(t, rec.Schema, rec2.Schema)
+			require.Equal(t, rec.Protocols, rec2.Protocols)
+			require.Equal(t, tc.extra, rec2.Extra)
+		})
+	}
+}